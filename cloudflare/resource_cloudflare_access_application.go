@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
@@ -43,7 +44,13 @@ func resourceCloudflareAccessApplication() *schema.Resource {
 			},
 			"domain": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+			},
+			"application_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "self_hosted",
+				ValidateFunc: validation.StringInSlice([]string{"self_hosted", "saas", "ssh", "vnc", "file"}, false),
 			},
 			"session_duration": {
 				Type:         schema.TypeString,
@@ -51,6 +58,13 @@ func resourceCloudflareAccessApplication() *schema.Resource {
 				Default:      "24h",
 				ValidateFunc: validation.StringInSlice([]string{"0s", "15m", "30m", "6h", "12h", "24h", "168h", "730h"}, false),
 			},
+			// NOTE(chunk0-3): the original request also asked for an
+			// exposed_headers/allow_all_exposed_headers pair modelling
+			// Access-Control-Expose-Headers. cloudflare.AccessApplicationCorsHeaders
+			// in the pinned cloudflare-go version has no expose-side field
+			// to marshal it onto, so that half of the request is descoped
+			// rather than delivered — only the allow_credentials validation
+			// below landed. Revisit once the client exposes ExposedHeaders.
 			"cors_headers": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -113,24 +127,256 @@ func resourceCloudflareAccessApplication() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"enable_binding_cookie": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"http_only_cookie_attribute": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"same_site_cookie_attribute": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"strict", "lax", "none"}, false),
+			},
+			"custom_deny_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"custom_deny_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"logo_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"skip_interstitial": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"service_auth_401_redirect": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"saas_app": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sp_entity_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"consumer_service_url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"name_id_format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "email",
+							ValidateFunc: validation.StringInSlice([]string{"email", "id"}, false),
+						},
+						"custom_attributes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"name_format": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"urn:oasis:names:tc:SAML:2.0:attrname-format:unspecified", "urn:oasis:names:tc:SAML:2.0:attrname-format:basic", "urn:oasis:names:tc:SAML:2.0:attrname-format:uri"}, false),
+									},
+									"friendly_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"required": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"source": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"public_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"idp_entity_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sso_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			// policy is only managed once it is configured: leaving it out
+			// entirely leaves any existing policies for this application
+			// untouched (whether created via the dashboard or the
+			// standalone cloudflare_access_policy resource), so the two
+			// never fight over ownership. Once at least one "policy" block
+			// is present, it fully owns the set of policies attached to the
+			// application and any name not present here is deleted on the
+			// next apply, so removing the last block does not implicitly
+			// delete the policies it used to manage — remove them from the
+			// dashboard or the standalone resource instead.
+			"policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"decision": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"allow", "deny", "non_identity", "bypass"}, false),
+						},
+						"precedence": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"session_duration": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "24h",
+							ValidateFunc: validation.StringInSlice([]string{"0s", "15m", "30m", "6h", "12h", "24h", "168h", "730h"}, false),
+						},
+						"include": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem:     accessApplicationPolicyGroupElem(),
+						},
+						"exclude": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     accessApplicationPolicyGroupElem(),
+						},
+						"require": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     accessApplicationPolicyGroupElem(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func accessApplicationPolicyGroupElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"emails": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"email_domains": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ip_ranges": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"service_token_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"geo": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"everyone": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"any_valid_service_token": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"auth_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
 	}
 }
 
 func resourceCloudflareAccessApplicationCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*cloudflare.API)
-	accountID, err := getAccountIDFromZoneID(d, client)
+	accountID, err := accessApplicationAccountID(d, client)
 	if err != nil {
 		return err
 	}
 
 	allowedIDPList := expandInterfaceToStringList(d.Get("allowed_idps"))
+	applicationType := d.Get("application_type").(string)
+
+	if applicationType != "saas" && d.Get("domain").(string) == "" {
+		return fmt.Errorf("domain is required unless application_type is \"saas\"")
+	}
+
+	if err := validateAccessApplicationSaasApp(d, applicationType); err != nil {
+		return err
+	}
 
 	newAccessApplication := cloudflare.AccessApplication{
-		Name:                   d.Get("name").(string),
-		Domain:                 d.Get("domain").(string),
-		SessionDuration:        d.Get("session_duration").(string),
-		AutoRedirectToIdentity: d.Get("auto_redirect_to_identity").(bool),
+		Name:                    d.Get("name").(string),
+		Domain:                  d.Get("domain").(string),
+		Type:                    applicationType,
+		SessionDuration:         d.Get("session_duration").(string),
+		AutoRedirectToIdentity:  d.Get("auto_redirect_to_identity").(bool),
+		EnableBindingCookie:     d.Get("enable_binding_cookie").(bool),
+		HttpOnlyCookieAttribute: d.Get("http_only_cookie_attribute").(bool),
+		SameSiteCookieAttribute: d.Get("same_site_cookie_attribute").(string),
+		CustomDenyMessage:       d.Get("custom_deny_message").(string),
+		CustomDenyURL:           d.Get("custom_deny_url").(string),
+		LogoURL:                 d.Get("logo_url").(string),
+		SkipInterstitial:        d.Get("skip_interstitial").(bool),
+		ServiceAuth401Redirect:  d.Get("service_auth_401_redirect").(bool),
 	}
 
 	if len(allowedIDPList) > 0 {
@@ -145,6 +391,10 @@ func resourceCloudflareAccessApplicationCreate(d *schema.ResourceData, meta inte
 		newAccessApplication.CorsHeaders = CORSConfig
 	}
 
+	if applicationType == "saas" {
+		newAccessApplication.SaasApplication = convertSAASSchemaToStruct(d)
+	}
+
 	log.Printf("[DEBUG] Creating Cloudflare Access Application from struct: %+v", newAccessApplication)
 
 	accessApplication, err := client.CreateAccessApplication(accountID, newAccessApplication)
@@ -155,12 +405,41 @@ func resourceCloudflareAccessApplicationCreate(d *schema.ResourceData, meta inte
 	d.SetId(accessApplication.ID)
 	d.Set("account_id", accountID)
 
+	if err := resourceCloudflareAccessApplicationCreatePolicies(d, client, accountID, accessApplication.ID); err != nil {
+		return err
+	}
+
 	return resourceCloudflareAccessApplicationRead(d, meta)
 }
 
+// resourceCloudflareAccessApplicationCreatePolicies creates the policies
+// attached to an Access Application in precedence order.
+func resourceCloudflareAccessApplicationCreatePolicies(d *schema.ResourceData, client *cloudflare.API, accountID, appID string) error {
+	rawPolicies := d.Get("policy").([]interface{})
+
+	policies := make([]cloudflare.AccessPolicy, 0, len(rawPolicies))
+	for _, rawPolicy := range rawPolicies {
+		policies = append(policies, convertAccessApplicationPolicySchemaToStruct(rawPolicy.(map[string]interface{})))
+	}
+
+	sort.SliceStable(policies, func(i, j int) bool {
+		return policies[i].Precedence < policies[j].Precedence
+	})
+
+	for _, policy := range policies {
+		log.Printf("[DEBUG] Creating Cloudflare Access Policy for application %q from struct: %+v", appID, policy)
+
+		if _, err := client.CreateAccessPolicy(accountID, appID, policy); err != nil {
+			return fmt.Errorf("error creating Access Policy for application %q: %s", appID, err)
+		}
+	}
+
+	return nil
+}
+
 func resourceCloudflareAccessApplicationRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*cloudflare.API)
-	accountID, err := getAccountIDFromZoneID(d, client)
+	accountID, err := accessApplicationAccountID(d, client)
 	if err != nil {
 		return err
 	}
@@ -178,31 +457,89 @@ func resourceCloudflareAccessApplicationRead(d *schema.ResourceData, meta interf
 	d.Set("aud", accessApplication.AUD)
 	d.Set("session_duration", accessApplication.SessionDuration)
 	d.Set("domain", accessApplication.Domain)
+	d.Set("application_type", accessApplication.Type)
 	d.Set("auto_redirect_to_identity", accessApplication.AutoRedirectToIdentity)
 	d.Set("allowed_idps", accessApplication.AllowedIdps)
+	d.Set("enable_binding_cookie", accessApplication.EnableBindingCookie)
+	d.Set("http_only_cookie_attribute", accessApplication.HttpOnlyCookieAttribute)
+	d.Set("same_site_cookie_attribute", accessApplication.SameSiteCookieAttribute)
+	d.Set("custom_deny_message", accessApplication.CustomDenyMessage)
+	d.Set("custom_deny_url", accessApplication.CustomDenyURL)
+	d.Set("logo_url", accessApplication.LogoURL)
+	d.Set("skip_interstitial", accessApplication.SkipInterstitial)
+	d.Set("service_auth_401_redirect", accessApplication.ServiceAuth401Redirect)
 
 	corsConfig := convertCORSStructToSchema(d, accessApplication.CorsHeaders)
 	if corsConfigErr := d.Set("cors_headers", corsConfig); corsConfigErr != nil {
 		return fmt.Errorf("error setting Access Application CORS header configuration: %s", corsConfigErr)
 	}
 
+	if accessApplication.SaasApplication != nil {
+		saasConfig := convertSAASStructToSchema(accessApplication.SaasApplication)
+		if saasConfigErr := d.Set("saas_app", saasConfig); saasConfigErr != nil {
+			return fmt.Errorf("error setting Access Application SAAS configuration: %s", saasConfigErr)
+		}
+	}
+
+	// Only read Access Policies into state when the "policy" block is
+	// actually configured. This resource can coexist with the standalone
+	// cloudflare_access_policy resource managing policies for the same
+	// application out-of-band; pulling every API-side policy into state
+	// unconditionally would make an app with no "policy" block delete those
+	// policies on the next apply (see resourceCloudflareAccessApplicationUpdatePolicies).
+	if _, ok := d.GetOk("policy"); ok {
+		policies, _, err := client.AccessPolicies(accountID, d.Id(), cloudflare.PaginationOptions{})
+		if err != nil {
+			return fmt.Errorf("error listing Access Policies for application %q: %s", d.Id(), err)
+		}
+
+		// The API does not guarantee policies are returned in precedence
+		// order, but the "policy" attribute is an ordered TypeList, so sort
+		// them here to avoid a perpetual diff against the configured order.
+		sort.Slice(policies, func(i, j int) bool {
+			return policies[i].Precedence < policies[j].Precedence
+		})
+
+		if policyErr := d.Set("policy", convertAccessApplicationPoliciesStructToSchema(policies)); policyErr != nil {
+			return fmt.Errorf("error setting Access Application policies: %s", policyErr)
+		}
+	}
+
 	return nil
 }
 
 func resourceCloudflareAccessApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*cloudflare.API)
-	accountID, err := getAccountIDFromZoneID(d, client)
+	accountID, err := accessApplicationAccountID(d, client)
 	if err != nil {
 		return err
 	}
 	allowedIDPList := expandInterfaceToStringList(d.Get("allowed_idps"))
+	applicationType := d.Get("application_type").(string)
+
+	if applicationType != "saas" && d.Get("domain").(string) == "" {
+		return fmt.Errorf("domain is required unless application_type is \"saas\"")
+	}
+
+	if err := validateAccessApplicationSaasApp(d, applicationType); err != nil {
+		return err
+	}
 
 	updatedAccessApplication := cloudflare.AccessApplication{
-		ID:                     d.Id(),
-		Name:                   d.Get("name").(string),
-		Domain:                 d.Get("domain").(string),
-		SessionDuration:        d.Get("session_duration").(string),
-		AutoRedirectToIdentity: d.Get("auto_redirect_to_identity").(bool),
+		ID:                      d.Id(),
+		Name:                    d.Get("name").(string),
+		Domain:                  d.Get("domain").(string),
+		Type:                    applicationType,
+		SessionDuration:         d.Get("session_duration").(string),
+		AutoRedirectToIdentity:  d.Get("auto_redirect_to_identity").(bool),
+		EnableBindingCookie:     d.Get("enable_binding_cookie").(bool),
+		HttpOnlyCookieAttribute: d.Get("http_only_cookie_attribute").(bool),
+		SameSiteCookieAttribute: d.Get("same_site_cookie_attribute").(string),
+		CustomDenyMessage:       d.Get("custom_deny_message").(string),
+		CustomDenyURL:           d.Get("custom_deny_url").(string),
+		LogoURL:                 d.Get("logo_url").(string),
+		SkipInterstitial:        d.Get("skip_interstitial").(bool),
+		ServiceAuth401Redirect:  d.Get("service_auth_401_redirect").(bool),
 	}
 
 	if len(allowedIDPList) > 0 {
@@ -217,6 +554,10 @@ func resourceCloudflareAccessApplicationUpdate(d *schema.ResourceData, meta inte
 		updatedAccessApplication.CorsHeaders = CORSConfig
 	}
 
+	if applicationType == "saas" {
+		updatedAccessApplication.SaasApplication = convertSAASSchemaToStruct(d)
+	}
+
 	log.Printf("[DEBUG] Updating Cloudflare Access Application from struct: %+v", updatedAccessApplication)
 
 	accessApplication, err := client.UpdateAccessApplication(accountID, updatedAccessApplication)
@@ -228,12 +569,90 @@ func resourceCloudflareAccessApplicationUpdate(d *schema.ResourceData, meta inte
 		return fmt.Errorf("failed to find Access Application ID in update response; resource was empty")
 	}
 
+	// Only reconcile Access Policies when the "policy" block is configured;
+	// see the matching guard in Read for why an app with no "policy" block
+	// must leave any existing policies (dashboard-created, or managed by the
+	// standalone cloudflare_access_policy resource) alone.
+	if _, ok := d.GetOk("policy"); ok {
+		if err := resourceCloudflareAccessApplicationUpdatePolicies(d, client, accountID, accessApplication.ID); err != nil {
+			return err
+		}
+	}
+
 	return resourceCloudflareAccessApplicationRead(d, meta)
 }
 
+// resourceCloudflareAccessApplicationUpdatePolicies reconciles the policies
+// attached to an Access Application with the configuration in place: policies
+// whose "name" matches an existing one are updated, new names are created,
+// and only names no longer present in the configuration are deleted. This
+// avoids leaving the application with a partial or empty policy set if a
+// request fails midway through.
+//
+// Reconciliation is keyed on "name" rather than the Computed "id": "policy"
+// is an ordered TypeList, so the "id" Terraform carries into a given config
+// block's diff is whatever was previously in state at that same list index.
+// Insert, remove, or reorder a block and that index no longer refers to the
+// same policy, so matching on it updates the wrong remote policy and deletes
+// a live one out from under the user. "name" is the Required, user-supplied
+// field instead, so it stays attached to the policy the user meant even
+// when the list is reshuffled.
+func resourceCloudflareAccessApplicationUpdatePolicies(d *schema.ResourceData, client *cloudflare.API, accountID, appID string) error {
+	existingPolicies, _, err := client.AccessPolicies(accountID, appID, cloudflare.PaginationOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing existing Access Policies for application %q: %s", appID, err)
+	}
+
+	existingByName := make(map[string]cloudflare.AccessPolicy, len(existingPolicies))
+	for _, existingPolicy := range existingPolicies {
+		existingByName[existingPolicy.Name] = existingPolicy
+	}
+
+	configuredPolicies := d.Get("policy").([]interface{})
+	keptIDs := make(map[string]bool, len(configuredPolicies))
+
+	for _, rawPolicy := range configuredPolicies {
+		policyMap := rawPolicy.(map[string]interface{})
+		policy := convertAccessApplicationPolicySchemaToStruct(policyMap)
+
+		if existingPolicy, ok := existingByName[policy.Name]; ok {
+			policy.ID = existingPolicy.ID
+			keptIDs[existingPolicy.ID] = true
+
+			log.Printf("[DEBUG] Updating Cloudflare Access Policy %q for application %q from struct: %+v", policy.ID, appID, policy)
+
+			if _, err := client.UpdateAccessPolicy(accountID, appID, policy); err != nil {
+				return fmt.Errorf("error updating Access Policy %q for application %q: %s", policy.ID, appID, err)
+			}
+
+			continue
+		}
+
+		log.Printf("[DEBUG] Creating Cloudflare Access Policy for application %q from struct: %+v", appID, policy)
+
+		if _, err := client.CreateAccessPolicy(accountID, appID, policy); err != nil {
+			return fmt.Errorf("error creating Access Policy for application %q: %s", appID, err)
+		}
+	}
+
+	for _, existingPolicy := range existingPolicies {
+		if keptIDs[existingPolicy.ID] {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting Cloudflare Access Policy %q for application %q", existingPolicy.ID, appID)
+
+		if err := client.DeleteAccessPolicy(accountID, appID, existingPolicy.ID); err != nil {
+			return fmt.Errorf("error deleting Access Policy %q for application %q: %s", existingPolicy.ID, appID, err)
+		}
+	}
+
+	return nil
+}
+
 func resourceCloudflareAccessApplicationDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*cloudflare.API)
-	accountID, err := getAccountIDFromZoneID(d, client)
+	accountID, err := accessApplicationAccountID(d, client)
 	if err != nil {
 		return err
 	}
@@ -258,16 +677,40 @@ func resourceCloudflareAccessApplicationImport(d *schema.ResourceData, meta inte
 		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/accessApplicationID\"", d.Id())
 	}
 
-	accountID, accessApplicationID := attributes[0], attributes[1]
+	accountID, accessApplicationIDs := attributes[0], attributes[1]
 
-	log.Printf("[DEBUG] Importing Cloudflare Access Application: id %s for account %s", accessApplicationID, accountID)
+	ids := strings.Split(accessApplicationIDs, ",")
 
-	d.Set("account_id", accountID)
-	d.SetId(accessApplicationID)
+	if len(ids) == 1 {
+		log.Printf("[DEBUG] Importing Cloudflare Access Application: id %s for account %s", ids[0], accountID)
 
-	resourceCloudflareAccessApplicationRead(d, meta)
+		d.Set("account_id", accountID)
+		d.SetId(ids[0])
+
+		if err := resourceCloudflareAccessApplicationRead(d, meta); err != nil {
+			return nil, err
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
 
-	return []*schema.ResourceData{d}, nil
+	log.Printf("[DEBUG] Importing %d Cloudflare Access Applications for account %s", len(ids), accountID)
+
+	resourceData := make([]*schema.ResourceData, 0, len(ids))
+
+	for _, accessApplicationID := range ids {
+		applicationData := resourceCloudflareAccessApplication().Data(nil)
+		applicationData.Set("account_id", accountID)
+		applicationData.SetId(accessApplicationID)
+
+		if err := resourceCloudflareAccessApplicationRead(applicationData, meta); err != nil {
+			return nil, fmt.Errorf("error importing Access Application %q: %s", accessApplicationID, err)
+		}
+
+		resourceData = append(resourceData, applicationData)
+	}
+
+	return resourceData, nil
 }
 
 func convertCORSSchemaToStruct(d *schema.ResourceData) (*cloudflare.AccessApplicationCorsHeaders, error) {
@@ -310,6 +753,21 @@ func convertCORSSchemaToStruct(d *schema.ResourceData) (*cloudflare.AccessApplic
 				return nil, errors.New("must set allowed_origins or allow_all_origins")
 			}
 		}
+
+		// The Access-Control-Allow-Credentials spec forbids pairing credentials
+		// with a wildcard origin; Cloudflare rejects this combination at
+		// runtime, so fail fast in plan/apply instead.
+		if CORSConfig.AllowCredentials {
+			if CORSConfig.AllowAllOrigins {
+				return nil, errors.New("allow_credentials is incompatible with allow_all_origins")
+			}
+
+			for _, origin := range CORSConfig.AllowedOrigins {
+				if origin == "*" {
+					return nil, errors.New("allow_credentials is incompatible with a wildcard allowed_origins entry")
+				}
+			}
+		}
 	}
 
 	return &CORSConfig, nil
@@ -334,3 +792,296 @@ func convertCORSStructToSchema(d *schema.ResourceData, headers *cloudflare.Acces
 
 	return []interface{}{m}
 }
+
+func convertAccessApplicationPolicySchemaToStruct(policy map[string]interface{}) cloudflare.AccessPolicy {
+	sessionDuration := policy["session_duration"].(string)
+
+	return cloudflare.AccessPolicy{
+		Name:            policy["name"].(string),
+		Decision:        policy["decision"].(string),
+		Precedence:      policy["precedence"].(int),
+		SessionDuration: &sessionDuration,
+		Include:         convertAccessApplicationPolicyGroupSchemaToStruct(policy["include"].([]interface{})),
+		Exclude:         convertAccessApplicationPolicyGroupSchemaToStruct(policy["exclude"].([]interface{})),
+		Require:         convertAccessApplicationPolicyGroupSchemaToStruct(policy["require"].([]interface{})),
+	}
+}
+
+func convertAccessApplicationPolicyGroupSchemaToStruct(group []interface{}) []interface{} {
+	conditions := []interface{}{}
+
+	if len(group) == 0 || group[0] == nil {
+		return conditions
+	}
+
+	g := group[0].(map[string]interface{})
+
+	for _, email := range expandInterfaceToStringList(g["emails"].(*schema.Set).List()) {
+		conditions = append(conditions, cloudflare.AccessGroupEmail{Email: struct {
+			Email string `json:"email"`
+		}{Email: email}})
+	}
+
+	for _, domain := range expandInterfaceToStringList(g["email_domains"].(*schema.Set).List()) {
+		conditions = append(conditions, cloudflare.AccessGroupEmailDomain{EmailDomain: struct {
+			Domain string `json:"domain"`
+		}{Domain: domain}})
+	}
+
+	for _, ip := range expandInterfaceToStringList(g["ip_ranges"].(*schema.Set).List()) {
+		conditions = append(conditions, cloudflare.AccessGroupIP{IP: struct {
+			IP string `json:"ip"`
+		}{IP: ip}})
+	}
+
+	for _, tokenID := range expandInterfaceToStringList(g["service_token_ids"].(*schema.Set).List()) {
+		conditions = append(conditions, cloudflare.AccessGroupServiceToken{ServiceToken: struct {
+			ID string `json:"token_id"`
+		}{ID: tokenID}})
+	}
+
+	for _, groupID := range expandInterfaceToStringList(g["groups"].(*schema.Set).List()) {
+		conditions = append(conditions, cloudflare.AccessGroupAccessGroup{Group: struct {
+			ID string `json:"id"`
+		}{ID: groupID}})
+	}
+
+	for _, countryCode := range expandInterfaceToStringList(g["geo"].(*schema.Set).List()) {
+		conditions = append(conditions, cloudflare.AccessGroupGeo{Geo: struct {
+			CountryCode string `json:"country_code"`
+		}{CountryCode: countryCode}})
+	}
+
+	if g["everyone"].(bool) {
+		conditions = append(conditions, cloudflare.AccessGroupEveryone{Everyone: struct{}{}})
+	}
+
+	if g["any_valid_service_token"].(bool) {
+		conditions = append(conditions, cloudflare.AccessGroupAnyValidServiceToken{AnyValidServiceToken: struct{}{}})
+	}
+
+	if authMethod, ok := g["auth_method"].(string); ok && authMethod != "" {
+		conditions = append(conditions, cloudflare.AccessGroupAuthMethod{AuthMethod: struct {
+			AuthMethod string `json:"auth_method"`
+		}{AuthMethod: authMethod}})
+	}
+
+	return conditions
+}
+
+func convertAccessApplicationPoliciesStructToSchema(policies []cloudflare.AccessPolicy) []interface{} {
+	schemas := make([]interface{}, 0, len(policies))
+
+	for _, policy := range policies {
+		sessionDuration := "24h"
+		if policy.SessionDuration != nil {
+			sessionDuration = *policy.SessionDuration
+		}
+
+		schemas = append(schemas, map[string]interface{}{
+			"id":               policy.ID,
+			"name":             policy.Name,
+			"decision":         policy.Decision,
+			"precedence":       policy.Precedence,
+			"session_duration": sessionDuration,
+			"include":          convertAccessApplicationPolicyGroupStructToSchema(policy.Include),
+			"exclude":          convertAccessApplicationPolicyGroupStructToSchema(policy.Exclude),
+			"require":          convertAccessApplicationPolicyGroupStructToSchema(policy.Require),
+		})
+	}
+
+	return schemas
+}
+
+func convertAccessApplicationPolicyGroupStructToSchema(conditions []interface{}) []interface{} {
+	if len(conditions) == 0 {
+		return []interface{}{}
+	}
+
+	emails := []interface{}{}
+	emailDomains := []interface{}{}
+	ipRanges := []interface{}{}
+	serviceTokenIDs := []interface{}{}
+	groups := []interface{}{}
+	geo := []interface{}{}
+	everyone := false
+	anyValidServiceToken := false
+	authMethod := ""
+
+	// client.AccessPolicies unmarshals Include/Exclude/Require straight from
+	// the API's JSON response, so each condition here is a generic
+	// map[string]interface{} keyed by condition type (e.g. {"email":
+	// {"email": "..."}}), not one of the typed cloudflare.AccessGroup*
+	// structs built in convertAccessApplicationPolicyGroupSchemaToStruct —
+	// those only exist on the create/marshal side. Parse by
+	// key instead of switching on a concrete type, or Read never round-trips
+	// the configured policy.
+	for _, rawCondition := range conditions {
+		condition, ok := rawCondition.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if email, ok := condition["email"].(map[string]interface{}); ok {
+			emails = append(emails, email["email"])
+		}
+
+		if emailDomain, ok := condition["email_domain"].(map[string]interface{}); ok {
+			emailDomains = append(emailDomains, emailDomain["domain"])
+		}
+
+		if ip, ok := condition["ip"].(map[string]interface{}); ok {
+			ipRanges = append(ipRanges, ip["ip"])
+		}
+
+		if serviceToken, ok := condition["service_token"].(map[string]interface{}); ok {
+			serviceTokenIDs = append(serviceTokenIDs, serviceToken["token_id"])
+		}
+
+		if group, ok := condition["group"].(map[string]interface{}); ok {
+			groups = append(groups, group["id"])
+		}
+
+		if geoCondition, ok := condition["geo"].(map[string]interface{}); ok {
+			geo = append(geo, geoCondition["country_code"])
+		}
+
+		if _, ok := condition["everyone"]; ok {
+			everyone = true
+		}
+
+		if _, ok := condition["any_valid_service_token"]; ok {
+			anyValidServiceToken = true
+		}
+
+		if authMethodCondition, ok := condition["auth_method"].(map[string]interface{}); ok {
+			authMethod, _ = authMethodCondition["auth_method"].(string)
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"emails":                  emails,
+			"email_domains":           emailDomains,
+			"ip_ranges":               ipRanges,
+			"service_token_ids":       serviceTokenIDs,
+			"groups":                  groups,
+			"geo":                     geo,
+			"everyone":                everyone,
+			"any_valid_service_token": anyValidServiceToken,
+			"auth_method":             authMethod,
+		},
+	}
+}
+
+// validateAccessApplicationSaasApp ensures saas_app is set if and only if
+// application_type is "saas": a saas_app block is otherwise silently dropped
+// (it's only attached to the API struct when the type is "saas"), and a
+// "saas" application with no saas_app block would send an empty SP
+// configuration.
+func validateAccessApplicationSaasApp(d *schema.ResourceData, applicationType string) error {
+	_, hasSaasApp := d.GetOk("saas_app")
+
+	if applicationType == "saas" && !hasSaasApp {
+		return fmt.Errorf("saas_app is required when application_type is \"saas\"")
+	}
+
+	if applicationType != "saas" && hasSaasApp {
+		return fmt.Errorf("saas_app is only valid when application_type is \"saas\"")
+	}
+
+	return nil
+}
+
+func convertSAASSchemaToStruct(d *schema.ResourceData) *cloudflare.SaasApplication {
+	SAASConfig := cloudflare.SaasApplication{
+		SPEntityID:         d.Get("saas_app.0.sp_entity_id").(string),
+		ConsumerServiceUrl: d.Get("saas_app.0.consumer_service_url").(string),
+		NameIDFormat:       d.Get("saas_app.0.name_id_format").(string),
+	}
+
+	customAttributes := d.Get("saas_app.0.custom_attributes").([]interface{})
+	for _, attr := range customAttributes {
+		attrMap := attr.(map[string]interface{})
+
+		SAASConfig.CustomAttributes = append(SAASConfig.CustomAttributes, cloudflare.CustomAttribute{
+			Name:         attrMap["name"].(string),
+			NameFormat:   attrMap["name_format"].(string),
+			FriendlyName: attrMap["friendly_name"].(string),
+			Required:     attrMap["required"].(bool),
+			Source: cloudflare.CustomAttributeSource{
+				Name: attrMap["source"].([]interface{})[0].(map[string]interface{})["name"].(string),
+			},
+		})
+	}
+
+	return &SAASConfig
+}
+
+func convertSAASStructToSchema(app *cloudflare.SaasApplication) []interface{} {
+	customAttributes := make([]interface{}, 0, len(app.CustomAttributes))
+	for _, attr := range app.CustomAttributes {
+		customAttributes = append(customAttributes, map[string]interface{}{
+			"name":          attr.Name,
+			"name_format":   attr.NameFormat,
+			"friendly_name": attr.FriendlyName,
+			"required":      attr.Required,
+			"source": []interface{}{
+				map[string]interface{}{
+					"name": attr.Source.Name,
+				},
+			},
+		})
+	}
+
+	m := map[string]interface{}{
+		"sp_entity_id":         app.SPEntityID,
+		"consumer_service_url": app.ConsumerServiceUrl,
+		"name_id_format":       app.NameIDFormat,
+		"public_key":           app.PublicKey,
+		"idp_entity_id":        app.IDPEntityID,
+		"sso_endpoint":         app.SSOEndpoint,
+		"custom_attributes":    customAttributes,
+	}
+
+	return []interface{}{m}
+}
+
+// accessApplicationAccountID resolves the account that scopes this Access
+// Application. zone_id is deprecated in favour of account_id and will be
+// removed in v3 of the provider; using it still works today, but resolves
+// the owning account on the caller's behalf and logs a deprecation warning
+// rather than only flagging it in the schema.
+//
+// cloudflare-go's Access endpoints in the pinned client version still take
+// a plain account ID string rather than a *cloudflare.ResourceContainer, so
+// unlike the newer workers endpoints there is no ResourceContainer to build
+// here yet.
+//
+// NOTE(chunk0-6): the original request asked for an internal
+// *cloudflare.ResourceContainer helper wired through every Access resource.
+// That's descoped for now: building one here doesn't compile against this
+// client version's Access signatures, and this provider snapshot has only
+// this one Access resource to wire it through. The deprecation-warning half
+// of the request is delivered below; revisit the ResourceContainer piece
+// once cloudflare-go is bumped past this version.
+func accessApplicationAccountID(d *schema.ResourceData, client *cloudflare.API) (string, error) {
+	accountID := d.Get("account_id").(string)
+	zoneID := d.Get("zone_id").(string)
+
+	// account_id is also Computed: once a zone_id-configured resource has
+	// been created, account_id is populated in state from the resolution
+	// below, so both can legitimately be non-empty at once. zone_id is the
+	// deprecated, explicit signal, so it always takes precedence when set.
+	if zoneID != "" {
+		log.Printf("[WARN] zone_id is deprecated for cloudflare_access_application and will be removed in v3 of the provider; use account_id instead")
+
+		return getAccountIDFromZoneID(d, client)
+	}
+
+	if accountID == "" {
+		return "", fmt.Errorf("either account_id or zone_id must be set")
+	}
+
+	return accountID, nil
+}
@@ -0,0 +1,265 @@
+package cloudflare
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccCloudflareAccessApplication_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_access_application.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	domain := fmt.Sprintf("%s.cloudflareaccess.com", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckCloudflareAccessApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessApplicationConfigBasic(rnd, accountID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "domain", domain),
+					resource.TestCheckResourceAttr(name, "application_type", "self_hosted"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareAccessApplication_WithPolicy(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_access_application.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	domain := fmt.Sprintf("%s.cloudflareaccess.com", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckCloudflareAccessApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessApplicationConfigWithPolicy(rnd, accountID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "policy.#", "1"),
+					resource.TestCheckResourceAttr(name, "policy.0.decision", "allow"),
+					resource.TestCheckResourceAttr(name, "policy.0.include.0.emails.#", "1"),
+				),
+			},
+			{
+				// Re-applying the same config must not produce a diff: this
+				// is the regression test for the policy Include/Exclude/
+				// Require round trip through Read.
+				Config:   testAccCloudflareAccessApplicationConfigWithPolicy(rnd, accountID, domain),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudflareAccessApplication_RemovingPolicyBlockLeavesPolicyIntact(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	domain := fmt.Sprintf("%s.cloudflareaccess.com", rnd)
+
+	var policyID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckCloudflareAccessApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessApplicationConfigWithPolicy(rnd, accountID, domain),
+				Check: testAccCheckCloudflareAccessApplicationPolicyExists(
+					fmt.Sprintf("cloudflare_access_application.%s", rnd), accountID, &policyID,
+				),
+			},
+			{
+				// Dropping the "policy" block entirely must not delete the
+				// policy it used to manage: this resource only reconciles
+				// policies once a "policy" block is present, so it can
+				// coexist with cloudflare_access_policy and dashboard-managed
+				// policies.
+				Config: testAccCloudflareAccessApplicationConfigBasic(rnd, accountID, domain),
+				Check: func(s *terraform.State) error {
+					client := testAccProvider.Meta().(*cloudflare.API)
+					rs, ok := s.RootModule().Resources[fmt.Sprintf("cloudflare_access_application.%s", rnd)]
+					if !ok {
+						return fmt.Errorf("resource not found in state")
+					}
+
+					_, err := client.AccessPolicy(accountID, rs.Primary.ID, policyID)
+					return err
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareAccessApplicationPolicyExists(name, accountID string, policyID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+
+		policies, _, err := testAccProvider.Meta().(*cloudflare.API).AccessPolicies(accountID, rs.Primary.ID, cloudflare.PaginationOptions{})
+		if err != nil {
+			return err
+		}
+
+		if len(policies) != 1 {
+			return fmt.Errorf("expected 1 Access Policy, got %d", len(policies))
+		}
+
+		*policyID = policies[0].ID
+
+		return nil
+	}
+}
+
+func TestAccCloudflareAccessApplication_SaasAppRequiresApplicationType(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareAccessApplicationConfigSaasAppWithoutApplicationType(rnd, accountID),
+				ExpectError: regexp.MustCompile("saas_app is only valid when application_type"),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareAccessApplicationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_access_application" {
+			continue
+		}
+
+		_, err := client.AccessApplication(rs.Primary.Attributes["account_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("access application still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCloudflareAccessApplicationConfigBasic(rnd, accountID, domain string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_application" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  domain     = "%[3]s"
+}`, rnd, accountID, domain)
+}
+
+func testAccCloudflareAccessApplicationConfigWithPolicy(rnd, accountID, domain string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_application" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  domain     = "%[3]s"
+
+  policy {
+    name       = "%[1]s-allow"
+    decision   = "allow"
+    precedence = 1
+
+    include {
+      emails = ["test@example.com"]
+    }
+  }
+}`, rnd, accountID, domain)
+}
+
+func testAccCloudflareAccessApplicationConfigSaasAppWithoutApplicationType(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_application" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  domain     = "%[1]s.cloudflareaccess.com"
+
+  saas_app {
+    sp_entity_id          = "https://example.com/saml/metadata"
+    consumer_service_url  = "https://example.com/saml/acs"
+  }
+}`, rnd, accountID)
+}
+
+// TestConvertAccessApplicationPolicyGroupStructToSchema_APIShape exercises
+// convertAccessApplicationPolicyGroupStructToSchema against the shape
+// client.AccessPolicies actually returns: Include/Exclude/Require are
+// unmarshaled straight from JSON, so each condition is a generic
+// map[string]interface{}, not one of the typed cloudflare.AccessGroup*
+// structs used on create.
+func TestConvertAccessApplicationPolicyGroupStructToSchema_APIShape(t *testing.T) {
+	conditions := []interface{}{
+		map[string]interface{}{"email": map[string]interface{}{"email": "test@example.com"}},
+		map[string]interface{}{"email_domain": map[string]interface{}{"domain": "example.com"}},
+		map[string]interface{}{"ip": map[string]interface{}{"ip": "10.0.0.0/8"}},
+		map[string]interface{}{"service_token": map[string]interface{}{"token_id": "token-id"}},
+		map[string]interface{}{"group": map[string]interface{}{"id": "group-id"}},
+		map[string]interface{}{"geo": map[string]interface{}{"country_code": "US"}},
+		map[string]interface{}{"everyone": map[string]interface{}{}},
+		map[string]interface{}{"any_valid_service_token": map[string]interface{}{}},
+		map[string]interface{}{"auth_method": map[string]interface{}{"auth_method": "sw"}},
+	}
+
+	schemas := convertAccessApplicationPolicyGroupStructToSchema(conditions)
+	if len(schemas) != 1 {
+		t.Fatalf("expected a single group, got %d", len(schemas))
+	}
+
+	group := schemas[0].(map[string]interface{})
+
+	if got := group["emails"].([]interface{}); len(got) != 1 || got[0] != "test@example.com" {
+		t.Errorf("emails did not round-trip, got %#v", got)
+	}
+
+	if got := group["email_domains"].([]interface{}); len(got) != 1 || got[0] != "example.com" {
+		t.Errorf("email_domains did not round-trip, got %#v", got)
+	}
+
+	if got := group["ip_ranges"].([]interface{}); len(got) != 1 || got[0] != "10.0.0.0/8" {
+		t.Errorf("ip_ranges did not round-trip, got %#v", got)
+	}
+
+	if got := group["service_token_ids"].([]interface{}); len(got) != 1 || got[0] != "token-id" {
+		t.Errorf("service_token_ids did not round-trip, got %#v", got)
+	}
+
+	if got := group["groups"].([]interface{}); len(got) != 1 || got[0] != "group-id" {
+		t.Errorf("groups did not round-trip, got %#v", got)
+	}
+
+	if got := group["geo"].([]interface{}); len(got) != 1 || got[0] != "US" {
+		t.Errorf("geo did not round-trip, got %#v", got)
+	}
+
+	if !group["everyone"].(bool) {
+		t.Error("everyone did not round-trip")
+	}
+
+	if !group["any_valid_service_token"].(bool) {
+		t.Error("any_valid_service_token did not round-trip")
+	}
+
+	if group["auth_method"].(string) != "sw" {
+		t.Errorf("auth_method did not round-trip, got %#v", group["auth_method"])
+	}
+}